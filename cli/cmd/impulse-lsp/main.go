@@ -0,0 +1,19 @@
+// Command impulse-lsp is a Language Server Protocol server for Impulse. It
+// speaks JSON-RPC 2.0 over stdio, the transport expected by editors that
+// launch language servers as a subprocess.
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/Flickyyy/Impulse-JIT/cli/internal/lsp"
+)
+
+func main() {
+	logger := log.New(os.Stderr, "impulse-lsp: ", log.LstdFlags)
+	server := lsp.NewServer(os.Stdin, os.Stdout, logger)
+	if err := server.Run(); err != nil {
+		logger.Fatalf("server exited: %v", err)
+	}
+}
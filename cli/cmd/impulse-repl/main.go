@@ -0,0 +1,176 @@
+// Command impulse-repl is an interactive, incremental Impulse session: each
+// line is checked and evaluated against the bindings accumulated from
+// everything entered before it, rather than re-processing the whole
+// transcript on every keystroke.
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Flickyyy/Impulse-JIT/cli/internal/frontend"
+	"github.com/Flickyyy/Impulse-JIT/cli/internal/replline"
+)
+
+const prompt = "impulse> "
+
+func main() {
+	session, err := frontend.NewSession()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to start session: %v\n", err)
+		os.Exit(1)
+	}
+	defer session.Close()
+
+	editor := replline.New(os.Stdin, os.Stdout)
+	editor.NeedsContinuation = needsContinuation
+	editor.Complete = func(prefix string) []string {
+		return completeBindingNames(session, prefix)
+	}
+
+	fmt.Println("Impulse REPL. Type :help for commands, Ctrl-D to exit.")
+	for {
+		line, err := editor.ReadLine(prompt)
+		if err != nil {
+			if err == replline.ErrInterrupted {
+				continue
+			}
+			fmt.Println()
+			return
+		}
+
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, ":") {
+			if !runCommand(session, line) {
+				return
+			}
+			continue
+		}
+
+		feedLine(session, line)
+	}
+}
+
+// needsContinuation treats a non-command line as unterminated until it ends
+// with a semicolon, so multi-line bindings can be entered across several
+// prompts.
+func needsContinuation(input string) bool {
+	trimmed := strings.TrimSpace(input)
+	if trimmed == "" || strings.HasPrefix(trimmed, ":") {
+		return false
+	}
+	return !strings.HasSuffix(trimmed, ";")
+}
+
+func completeBindingNames(session *frontend.Session, prefix string) []string {
+	var matches []string
+	for _, sym := range session.Symbols() {
+		if strings.HasPrefix(sym.Name, prefix) {
+			matches = append(matches, sym.Name)
+		}
+	}
+	return matches
+}
+
+func feedLine(session *frontend.Session, line string) {
+	result, err := session.Feed(line)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		return
+	}
+	if !result.Success {
+		printDiagnostics(result.Diagnostics)
+		return
+	}
+	printDiagnostics(result.Diagnostics)
+}
+
+// runCommand handles a leading-":" REPL command. It returns false if the
+// REPL should exit.
+func runCommand(session *frontend.Session, line string) bool {
+	command, arg, _ := strings.Cut(line, " ")
+	arg = strings.TrimSpace(arg)
+
+	switch command {
+	case ":help":
+		fmt.Println(":ir <expr>    show the IR for an expression")
+		fmt.Println(":type <expr>  evaluate an expression and show its value")
+		fmt.Println(":load <file>  feed a file's bindings into the session")
+		fmt.Println(":reset        discard every binding entered so far")
+		fmt.Println(":quit         exit the REPL")
+
+	case ":quit", ":exit":
+		return false
+
+	case ":reset":
+		if err := session.Reset(); err != nil {
+			fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		}
+
+	case ":load":
+		if arg == "" {
+			fmt.Fprintln(os.Stderr, "usage: :load <file>")
+			break
+		}
+		source, err := os.ReadFile(arg)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: %v\n", err)
+			break
+		}
+		feedLine(session, string(source))
+
+	case ":ir":
+		if arg == "" {
+			fmt.Fprintln(os.Stderr, "usage: :ir <expr>")
+			break
+		}
+		irResult, err := session.EmitIR(arg)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: %v\n", err)
+			break
+		}
+		if !irResult.Success {
+			printDiagnostics(irResult.Diagnostics)
+			break
+		}
+		fmt.Println(irResult.IR)
+
+	case ":type":
+		if arg == "" {
+			fmt.Fprintln(os.Stderr, "usage: :type <expr>")
+			break
+		}
+		evalResult, err := session.Eval(arg)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: %v\n", err)
+			break
+		}
+		if !evalResult.Success {
+			printDiagnostics(evalResult.Diagnostics)
+			break
+		}
+		for _, binding := range evalResult.Bindings {
+			if binding.Evaluated {
+				fmt.Printf("%g\n", binding.Value)
+			} else {
+				fmt.Println(binding.Message)
+			}
+		}
+
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q (try :help)\n", command)
+	}
+
+	return true
+}
+
+func printDiagnostics(diags []frontend.Diagnostic) {
+	for _, diag := range diags {
+		fmt.Fprintf(os.Stderr, "[%d:%d] %s\n", diag.Line, diag.Column, diag.Message)
+	}
+}
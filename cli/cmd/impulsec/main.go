@@ -1,14 +1,29 @@
 package main
 
 import (
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/Flickyyy/Impulse-JIT/cli/internal/frontend"
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "build":
+			runBuildCommand(os.Args[2:])
+			return
+		case "run":
+			runRunCommand(os.Args[2:])
+			return
+		}
+	}
+
 	sourcePath := flag.String("file", "", "Impulse source file to parse")
 	emitIR := flag.Bool("emit-ir", false, "Emit textual IR after parsing")
 	check := flag.Bool("check", false, "Run semantic checks")
@@ -16,8 +31,15 @@ func main() {
 	evalBinding := flag.String("eval-binding", "", "Evaluate only the specified binding (implies --evaluate)")
 	runModule := flag.Bool("run", false, "Run the module by treating a constant binding as the entry point")
 	entryBinding := flag.String("entry-binding", "", "Binding name to use as the entry point (defaults to 'main')")
+	format := flag.String("format", "text", "Output format: text or json")
 	flag.Parse()
 
+	if *format != "text" && *format != "json" {
+		fmt.Fprintf(os.Stderr, "unknown --format %q (want text or json)\n", *format)
+		os.Exit(1)
+	}
+	asJSON := *format == "json"
+
 	if *sourcePath == "" {
 		fmt.Fprintln(os.Stderr, "usage: impulsec --file <path>")
 		os.Exit(1)
@@ -39,6 +61,19 @@ func main() {
 			fmt.Fprintf(os.Stderr, "IR emission error: %v\n", err)
 			os.Exit(1)
 		}
+		if asJSON {
+			exitCode := 0
+			if !irResult.Success {
+				exitCode = 2
+			}
+			emitJSON(frontend.Report{
+				Success:     irResult.Success,
+				Diagnostics: frontend.DiagnosticsJSON(irResult.Diagnostics),
+				IR:          irResult.IR,
+				ExitCode:    exitCode,
+			})
+			os.Exit(exitCode)
+		}
 		if !irResult.Success {
 			fmt.Fprintf(os.Stderr, "IR emission failed with %d diagnostics:\n", len(irResult.Diagnostics))
 			printDiagnostics(irResult.Diagnostics)
@@ -54,6 +89,18 @@ func main() {
 			fmt.Fprintf(os.Stderr, "semantic error: %v\n", err)
 			os.Exit(1)
 		}
+		if asJSON {
+			exitCode := 0
+			if !checkResult.Success {
+				exitCode = 2
+			}
+			emitJSON(frontend.Report{
+				Success:     checkResult.Success,
+				Diagnostics: frontend.DiagnosticsJSON(checkResult.Diagnostics),
+				ExitCode:    exitCode,
+			})
+			os.Exit(exitCode)
+		}
 		if !checkResult.Success {
 			fmt.Fprintf(os.Stderr, "semantic check failed with %d diagnostics:\n", len(checkResult.Diagnostics))
 			printDiagnostics(checkResult.Diagnostics)
@@ -73,6 +120,23 @@ func main() {
 			fmt.Fprintf(os.Stderr, "run error: %v\n", err)
 			os.Exit(1)
 		}
+		if asJSON {
+			exitCode := 0
+			if !runResult.Success {
+				exitCode = 2
+			} else if runResult.HasExitCode {
+				exitCode = runResult.ExitCode
+			}
+			emitJSON(frontend.Report{
+				Success:     runResult.Success,
+				Diagnostics: frontend.DiagnosticsJSON(runResult.Diagnostics),
+				ExitCode:    exitCode,
+			})
+			if !runResult.Success {
+				os.Exit(2)
+			}
+			return
+		}
 		if !runResult.Success {
 			fmt.Fprintf(os.Stderr, "run failed\n")
 			if len(runResult.Diagnostics) > 0 {
@@ -104,6 +168,25 @@ func main() {
 			fmt.Fprintf(os.Stderr, "evaluation error: %v\n", err)
 			os.Exit(1)
 		}
+		if asJSON {
+			bindings := evalResult.Bindings
+			if bindingName != "" {
+				bindings = filterBinding(bindings, bindingName)
+			}
+			exitCode := 0
+			if !evalResult.Success {
+				exitCode = 2
+			} else if bindingName != "" && len(bindings) == 0 {
+				exitCode = 3
+			}
+			emitJSON(frontend.Report{
+				Success:     evalResult.Success,
+				Diagnostics: frontend.DiagnosticsJSON(evalResult.Diagnostics),
+				Bindings:    frontend.BindingsJSON(bindings),
+				ExitCode:    exitCode,
+			})
+			os.Exit(exitCode)
+		}
 		if !evalResult.Success {
 			fmt.Fprintf(os.Stderr, "evaluation failed with %d diagnostics:\n", len(evalResult.Diagnostics))
 			printDiagnostics(evalResult.Diagnostics)
@@ -130,6 +213,19 @@ func main() {
 		os.Exit(1)
 	}
 
+	if asJSON {
+		exitCode := 0
+		if !result.Success {
+			exitCode = 2
+		}
+		emitJSON(frontend.Report{
+			Success:     result.Success,
+			Diagnostics: frontend.DiagnosticsJSON(result.Diagnostics),
+			ExitCode:    exitCode,
+		})
+		os.Exit(exitCode)
+	}
+
 	if !result.Success {
 		fmt.Fprintf(os.Stderr, "parse failed with %d diagnostics:\n", len(result.Diagnostics))
 		printDiagnostics(result.Diagnostics)
@@ -139,6 +235,28 @@ func main() {
 	fmt.Println("Parse successful")
 }
 
+// emitJSON writes report to stdout as a single JSON document, the --format=json
+// counterpart to the text printers below.
+func emitJSON(report frontend.Report) {
+	if report.Diagnostics == nil {
+		report.Diagnostics = []frontend.DiagnosticJSON{}
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	if err := encoder.Encode(report); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to encode JSON report: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func filterBinding(bindings []frontend.BindingValue, name string) []frontend.BindingValue {
+	for _, binding := range bindings {
+		if binding.Name == name {
+			return []frontend.BindingValue{binding}
+		}
+	}
+	return nil
+}
+
 func printDiagnostics(diags []frontend.Diagnostic) {
 	for _, diag := range diags {
 		fmt.Fprintf(os.Stderr, "[%d:%d] %s\n", diag.Line, diag.Column, diag.Message)
@@ -175,3 +293,144 @@ func printBinding(binding frontend.BindingValue) {
 	}
 	fmt.Printf("%s = <unevaluated> (%s)\n", binding.Name, message)
 }
+
+// runBuildCommand handles `impulsec build <packages...>`, e.g.
+// `impulsec build ./...`. It loads and checks every package reachable from
+// the given import paths/directories and reports diagnostics for each.
+func runBuildCommand(args []string) {
+	packages, _, err := frontend.LoadPackages(args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "build error: %v\n", err)
+		os.Exit(1)
+	}
+
+	failed := false
+	for _, pkg := range packages {
+		if len(pkg.Diagnostics) > 0 {
+			failed = true
+			fmt.Fprintf(os.Stderr, "%s:\n", pkg.ImportPath)
+			printDiagnostics(pkg.Diagnostics)
+		}
+	}
+	if failed {
+		os.Exit(2)
+	}
+	fmt.Printf("Built %d package(s)\n", len(packages))
+}
+
+// runRunCommand handles `impulsec run <package> [-- args...]`, e.g.
+// `impulsec run ./cmd/foo`. It loads the package graph rooted at the given
+// path and, if every package checks out, runs the root package's entry
+// binding. Anything after the packages (trailing passthrough args) is
+// reserved for the eventual runtime and is currently ignored.
+func runRunCommand(args []string) {
+	packages, passthrough, err := frontend.LoadPackages(args)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "run error: %v\n", err)
+		os.Exit(1)
+	}
+
+	failed := false
+	for _, pkg := range packages {
+		if len(pkg.Diagnostics) > 0 {
+			failed = true
+			fmt.Fprintf(os.Stderr, "%s:\n", pkg.ImportPath)
+			printDiagnostics(pkg.Diagnostics)
+		}
+	}
+	if failed {
+		os.Exit(2)
+	}
+
+	packageArgs := args[:len(args)-len(passthrough)]
+	root, err := findRootPackage(packages, packageArgs)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "run error: %v\n", err)
+		os.Exit(1)
+	}
+	if len(root.Files) == 0 {
+		fmt.Fprintf(os.Stderr, "run error: package %s has no files\n", root.ImportPath)
+		os.Exit(1)
+	}
+
+	files := collectRunFiles(packages, root)
+
+	var source strings.Builder
+	for _, file := range files {
+		contents, err := os.ReadFile(file)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "run error: %v\n", err)
+			os.Exit(1)
+		}
+		source.Write(contents)
+		source.WriteString("\n")
+	}
+
+	runResult, err := frontend.RunModule(source.String(), "main")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "run error: %v\n", err)
+		os.Exit(1)
+	}
+	if !runResult.Success {
+		fmt.Fprintln(os.Stderr, "run failed")
+		if len(runResult.Diagnostics) > 0 {
+			printDiagnostics(runResult.Diagnostics)
+		}
+		os.Exit(2)
+	}
+	if runResult.HasExitCode {
+		fmt.Printf("Program exited with %d\n", runResult.ExitCode)
+	} else {
+		fmt.Println("Program ran successfully")
+	}
+	if len(passthrough) > 0 {
+		fmt.Fprintf(os.Stderr, "note: passthrough args %v are not yet forwarded to the runtime\n", passthrough)
+	}
+}
+
+// collectRunFiles returns every file that needs to be fed to RunModule for
+// root to resolve: the transitive closure of root.Depends, in dependency
+// order, followed by root's own files. Without this, cross-package
+// references that `build` validated against the full graph (e.g.
+// `let b: int = a + 3;` with `a` in another package) would fail at run
+// time because only the root package's source was included.
+func collectRunFiles(packages []*frontend.Package, root *frontend.Package) []string {
+	byImportPath := make(map[string]*frontend.Package, len(packages))
+	for _, pkg := range packages {
+		byImportPath[pkg.ImportPath] = pkg
+	}
+
+	var files []string
+	visited := make(map[string]bool)
+	var visit func(pkg *frontend.Package)
+	visit = func(pkg *frontend.Package) {
+		if pkg == nil || visited[pkg.ImportPath] {
+			return
+		}
+		visited[pkg.ImportPath] = true
+		for _, dep := range pkg.Depends {
+			visit(byImportPath[dep])
+		}
+		files = append(files, pkg.Files...)
+	}
+	visit(root)
+	return files
+}
+
+// findRootPackage picks the package the user asked to run out of the
+// loaded graph by matching it against the package argument(s) actually
+// passed on the command line (e.g. "./cmd/foo"), rather than assuming
+// LoadPackages returns the root package last.
+func findRootPackage(packages []*frontend.Package, packageArgs []string) (*frontend.Package, error) {
+	if len(packageArgs) == 0 {
+		return nil, errors.New("no package specified")
+	}
+
+	want := filepath.Clean(packageArgs[len(packageArgs)-1])
+	for _, pkg := range packages {
+		if filepath.Clean(pkg.ImportPath) == want {
+			return pkg, nil
+		}
+	}
+	return nil, fmt.Errorf("requested package %q not found among loaded packages", packageArgs[len(packageArgs)-1])
+}
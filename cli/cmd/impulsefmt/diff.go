@@ -0,0 +1,216 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines of context kept around each
+// hunk, matching `diff -u`'s default.
+const diffContext = 3
+
+// unifiedDiff returns a real unified diff (the format `patch`/`git apply`
+// understand: `--- `/`+++ ` headers, `@@ -l,s +l,s @@` hunk headers, and
+// `-`/`+`/` ` line markers with no extra space) between the lines of
+// before and after, labeling the two sides with fromLabel/toLabel. It
+// returns "" if the inputs are identical.
+func unifiedDiff(fromLabel, toLabel, before, after string) string {
+	a := splitLines(before)
+	b := splitLines(after)
+	ops := opcodes(a, b)
+	if len(ops) == 0 {
+		return ""
+	}
+
+	groups := groupOpcodes(ops, diffContext)
+	if len(groups) == 0 {
+		return ""
+	}
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "--- %s\n", fromLabel)
+	fmt.Fprintf(&out, "+++ %s\n", toLabel)
+	for _, group := range groups {
+		writeHunk(&out, a, b, group)
+	}
+	return out.String()
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	lines := strings.Split(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+type diffKind int
+
+const (
+	diffEqual diffKind = iota
+	diffDelete
+	diffInsert
+)
+
+// opcode describes a contiguous run of equal, deleted, or inserted lines as
+// half-open ranges into a and b, the same shape as Python difflib's
+// get_opcodes().
+type opcode struct {
+	kind         diffKind
+	aStart, aEnd int
+	bStart, bEnd int
+}
+
+// opcodes computes a line-level diff of a and b using the standard
+// longest-common-subsequence backtrack, merging consecutive same-kind
+// single-line steps into opcode ranges as it goes.
+func opcodes(a, b []string) []opcode {
+	n, m := len(a), len(b)
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var ops []opcode
+	push := func(kind diffKind, aStart, aEnd, bStart, bEnd int) {
+		if aStart == aEnd && bStart == bEnd {
+			return
+		}
+		if len(ops) > 0 && ops[len(ops)-1].kind == kind {
+			ops[len(ops)-1].aEnd = aEnd
+			ops[len(ops)-1].bEnd = bEnd
+			return
+		}
+		ops = append(ops, opcode{kind: kind, aStart: aStart, aEnd: aEnd, bStart: bStart, bEnd: bEnd})
+	}
+
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			push(diffEqual, i, i+1, j, j+1)
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			push(diffDelete, i, i+1, j, j)
+			i++
+		default:
+			push(diffInsert, i, i, j, j+1)
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		push(diffDelete, i, i+1, j, j)
+	}
+	for ; j < m; j++ {
+		push(diffInsert, i, i, j, j+1)
+	}
+	return ops
+}
+
+// groupOpcodes buckets opcodes into hunks, each keeping up to n lines of
+// equal context around its changes and splitting long equal runs between
+// hunks, mirroring Python difflib's get_grouped_opcodes.
+func groupOpcodes(ops []opcode, n int) [][]opcode {
+	if len(ops) == 0 {
+		return nil
+	}
+
+	codes := append([]opcode(nil), ops...)
+	if codes[0].kind == diffEqual {
+		c := codes[0]
+		c.aStart = max(c.aStart, c.aEnd-n)
+		c.bStart = max(c.bStart, c.bEnd-n)
+		codes[0] = c
+	}
+	if last := len(codes) - 1; codes[last].kind == diffEqual {
+		c := codes[last]
+		c.aEnd = min(c.aEnd, c.aStart+n)
+		c.bEnd = min(c.bEnd, c.bStart+n)
+		codes[last] = c
+	}
+
+	var groups [][]opcode
+	var group []opcode
+	for _, c := range codes {
+		if c.kind == diffEqual && c.aEnd-c.aStart > n*2 {
+			group = append(group, opcode{kind: diffEqual, aStart: c.aStart, aEnd: min(c.aEnd, c.aStart+n), bStart: c.bStart, bEnd: min(c.bEnd, c.bStart+n)})
+			groups = append(groups, group)
+			group = nil
+			c.aStart, c.bStart = max(c.aStart, c.aEnd-n), max(c.bStart, c.bEnd-n)
+		}
+		group = append(group, c)
+	}
+	if len(group) > 0 && !(len(group) == 1 && group[0].kind == diffEqual) {
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+func writeHunk(out *strings.Builder, a, b []string, group []opcode) {
+	aStart, aEnd := group[0].aStart, group[len(group)-1].aEnd
+	bStart, bEnd := group[0].bStart, group[len(group)-1].bEnd
+
+	fmt.Fprintf(out, "@@ -%s +%s @@\n", hunkRange(aStart, aEnd), hunkRange(bStart, bEnd))
+	for _, c := range group {
+		switch c.kind {
+		case diffEqual:
+			for _, line := range a[c.aStart:c.aEnd] {
+				out.WriteString(" " + line + "\n")
+			}
+		case diffDelete:
+			for _, line := range a[c.aStart:c.aEnd] {
+				out.WriteString("-" + line + "\n")
+			}
+		case diffInsert:
+			for _, line := range b[c.bStart:c.bEnd] {
+				out.WriteString("+" + line + "\n")
+			}
+		}
+	}
+}
+
+// hunkRange renders a 0-indexed half-open [start,end) range as the 1-indexed
+// "line,count" form used in @@ headers. A zero-length range (a pure
+// insertion at this position, or a pure deletion to EOF) is reported as
+// starting at line `start` rather than `start+1` — the convention real
+// `diff -u`/`git apply` expect (e.g. "@@ -0,0 +1 @@" for an insert at the
+// very top of a file).
+func hunkRange(start, end int) string {
+	count := end - start
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUnifiedDiffNoChange(t *testing.T) {
+	if diff := unifiedDiff("a", "b", "same\n", "same\n"); diff != "" {
+		t.Fatalf("expected no diff, got %q", diff)
+	}
+}
+
+func TestUnifiedDiffShowsChangedLine(t *testing.T) {
+	diff := unifiedDiff("before.impl", "after.impl", "let a: int=1;\n", "let a: int = 1;\n")
+	if !strings.Contains(diff, "--- before.impl") || !strings.Contains(diff, "+++ after.impl") {
+		t.Fatalf("expected diff headers, got %q", diff)
+	}
+	if !strings.Contains(diff, "@@ -1 +1 @@") {
+		t.Fatalf("expected a hunk header, got %q", diff)
+	}
+	if !strings.Contains(diff, "-let a: int=1;") {
+		t.Fatalf("expected deleted line with no space after the marker, got %q", diff)
+	}
+	if !strings.Contains(diff, "+let a: int = 1;") {
+		t.Fatalf("expected inserted line with no space after the marker, got %q", diff)
+	}
+}
+
+func TestUnifiedDiffKeepsContextAndSplitsHunks(t *testing.T) {
+	before := strings.Repeat("line\n", 10)
+	beforeLines := strings.Split(strings.TrimRight(before, "\n"), "\n")
+	afterLines := append([]string(nil), beforeLines...)
+	afterLines[0] = "changed-start"
+	afterLines[len(afterLines)-1] = "changed-end"
+	after := strings.Join(afterLines, "\n") + "\n"
+
+	diff := unifiedDiff("a", "b", before, after)
+	if strings.Count(diff, "@@") != 4 {
+		t.Fatalf("expected two separate hunks (4 @@ markers), got:\n%s", diff)
+	}
+}
+
+func TestHunkRangeZeroLength(t *testing.T) {
+	if got := hunkRange(0, 0); got != "0,0" {
+		t.Fatalf("expected 0,0 for an insertion at the top of a file, got %q", got)
+	}
+	if got := hunkRange(5, 5); got != "5,0" {
+		t.Fatalf("expected 5,0 for a zero-length range at line 5, got %q", got)
+	}
+}
+
+func TestUnifiedDiffInsertAtTopOfFile(t *testing.T) {
+	diff := unifiedDiff("a", "b", "", "new line\n")
+	if !strings.Contains(diff, "@@ -0,0 +1 @@") {
+		t.Fatalf("expected @@ -0,0 +1 @@ for an insert at the very top, got:\n%s", diff)
+	}
+}
+
+func TestUnifiedDiffDeleteToEOF(t *testing.T) {
+	diff := unifiedDiff("a", "b", "only line\n", "")
+	if !strings.Contains(diff, "@@ -1 +0,0 @@") {
+		t.Fatalf("expected @@ -1 +0,0 @@ for a deletion to EOF, got:\n%s", diff)
+	}
+}
+
+func TestUnifiedDiffIsPatchable(t *testing.T) {
+	diff := unifiedDiff("a", "b", "x\ny\nz\n", "x\nY\nz\n")
+	for _, line := range strings.Split(diff, "\n") {
+		if line == "" || strings.HasPrefix(line, "---") || strings.HasPrefix(line, "+++") || strings.HasPrefix(line, "@@") {
+			continue
+		}
+		if line[0] != ' ' && line[0] != '-' && line[0] != '+' {
+			t.Fatalf("line has no unified-diff marker: %q", line)
+		}
+		if len(line) > 1 && line[1] == ' ' && (line[0] == '-' || line[0] == '+') {
+			t.Fatalf("marker has a trailing space before content: %q", line)
+		}
+	}
+}
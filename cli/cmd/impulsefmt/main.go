@@ -0,0 +1,94 @@
+// Command impulsefmt is the canonical formatter for Impulse source, in the
+// spirit of gofmt: it re-prints a file's AST with consistent whitespace and
+// binding alignment while preserving the original comments and blank lines.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/Flickyyy/Impulse-JIT/cli/internal/frontend"
+)
+
+func main() {
+	write := flag.Bool("w", false, "write result to the source file instead of stdout")
+	showDiff := flag.Bool("d", false, "display a diff instead of rewriting the file")
+	list := flag.Bool("l", false, "list files whose formatting differs from impulsefmt's")
+	check := flag.Bool("check", false, "exit with a non-zero status if any file would be reformatted")
+	flag.Parse()
+
+	paths := flag.Args()
+	if len(paths) == 0 {
+		fmt.Fprintln(os.Stderr, "usage: impulsefmt [-w] [-d] [-l] [--check] file...")
+		os.Exit(1)
+	}
+
+	changed := false
+	failed := false
+	for _, path := range paths {
+		if err := formatFile(path, *write, *showDiff, *list, *check); err != nil {
+			if err == errWouldChange {
+				changed = true
+				continue
+			}
+			fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+			failed = true
+		}
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+	if *check && changed {
+		os.Exit(2)
+	}
+}
+
+// errWouldChange is a sentinel signaling that --check found a file that
+// would be reformatted; it isn't a real error so it doesn't print.
+var errWouldChange = fmt.Errorf("file would be reformatted")
+
+func formatFile(path string, write, showDiff, list, check bool) error {
+	source, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read source: %w", err)
+	}
+
+	result, err := frontend.FormatSource(string(source))
+	if err != nil {
+		return err
+	}
+	if !result.Success {
+		for _, diag := range result.Diagnostics {
+			fmt.Fprintf(os.Stderr, "[%d:%d] %s\n", diag.Line, diag.Column, diag.Message)
+		}
+		return fmt.Errorf("formatting failed")
+	}
+
+	unchanged := result.Formatted == string(source)
+
+	switch {
+	case list:
+		if !unchanged {
+			fmt.Println(path)
+		}
+	case showDiff:
+		if diff := unifiedDiff(path+".orig", path, string(source), result.Formatted); diff != "" {
+			fmt.Print(diff)
+		}
+	case write:
+		if !unchanged {
+			if err := os.WriteFile(path, []byte(result.Formatted), 0o644); err != nil {
+				return fmt.Errorf("failed to write formatted source: %w", err)
+			}
+		}
+	default:
+		fmt.Print(result.Formatted)
+	}
+
+	if check && !unchanged {
+		return errWouldChange
+	}
+	return nil
+}
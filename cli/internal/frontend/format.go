@@ -0,0 +1,52 @@
+package frontend
+
+/*
+#cgo CXXFLAGS: -I${SRCDIR}/../../../frontend/include
+#cgo LDFLAGS: -L${SRCDIR}/../../../build/frontend -L${SRCDIR}/../../../build/ir -limpulse-frontend -limpulse-ir -lstdc++ -lm
+#include <stdlib.h>
+#include "../../../frontend/include/impulse/frontend/frontend.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"unsafe"
+)
+
+// FormatResult is the outcome of formatting a source file.
+type FormatResult struct {
+	Success     bool
+	Formatted   string
+	Diagnostics []Diagnostic
+}
+
+// FormatSource re-prints source with canonical whitespace, binding
+// alignment, and trivia (comments, blank lines, trailing commas) preserved
+// from the original AST. It parses source itself, so a syntax error yields
+// Success=false with the parser's diagnostics rather than a Go error.
+func FormatSource(source string) (FormatResult, error) {
+	if source == "" {
+		return FormatResult{}, errors.New("empty source")
+	}
+
+	cSource := C.CString(source)
+	defer C.free(unsafe.Pointer(cSource))
+
+	options := C.struct_ImpulseParseOptions{
+		path:   nil,
+		source: cSource,
+	}
+
+	cResult := C.impulse_format_source(&options)
+	defer C.impulse_free_format_result(&cResult)
+
+	result := FormatResult{
+		Success:     bool(cResult.success),
+		Diagnostics: convertDiagnostics(cResult.diagnostics, cResult.diagnostic_count),
+	}
+	if cResult.formatted != nil {
+		result.Formatted = C.GoString(cResult.formatted)
+	}
+
+	return result, nil
+}
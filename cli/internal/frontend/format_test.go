@@ -0,0 +1,84 @@
+package frontend
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestFormatSourceGolden formats every testdata/format/*.impl file and
+// compares the result byte-for-byte to its *.golden counterpart, mirroring
+// the print-golden pattern used elsewhere in the toolchain.
+func TestFormatSourceGolden(t *testing.T) {
+	inputs, err := filepath.Glob("testdata/format/*.impl")
+	if err != nil {
+		t.Fatalf("failed to glob testdata: %v", err)
+	}
+	if len(inputs) == 0 {
+		t.Fatal("expected at least one testdata/format/*.impl fixture")
+	}
+
+	for _, input := range inputs {
+		input := input
+		t.Run(filepath.Base(input), func(t *testing.T) {
+			source, err := os.ReadFile(input)
+			if err != nil {
+				t.Fatalf("failed to read %s: %v", input, err)
+			}
+
+			result, err := FormatSource(string(source))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !result.Success {
+				t.Fatalf("formatting failed: %+v", result.Diagnostics)
+			}
+
+			goldenPath := strings.TrimSuffix(input, ".impl") + ".golden"
+			golden, err := os.ReadFile(goldenPath)
+			if err != nil {
+				t.Fatalf("failed to read golden file: %v", err)
+			}
+			if result.Formatted != string(golden) {
+				t.Fatalf("formatted output does not match golden:\ngot:\n%s\nwant:\n%s", result.Formatted, golden)
+			}
+		})
+	}
+}
+
+// TestFormatSourceIdempotent re-formats each golden file and asserts it is
+// unchanged: the formatter must be a fixed point on its own output.
+func TestFormatSourceIdempotent(t *testing.T) {
+	goldens, err := filepath.Glob("testdata/format/*.golden")
+	if err != nil {
+		t.Fatalf("failed to glob testdata: %v", err)
+	}
+
+	for _, goldenPath := range goldens {
+		goldenPath := goldenPath
+		t.Run(filepath.Base(goldenPath), func(t *testing.T) {
+			golden, err := os.ReadFile(goldenPath)
+			if err != nil {
+				t.Fatalf("failed to read golden file: %v", err)
+			}
+
+			result, err := FormatSource(string(golden))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !result.Success {
+				t.Fatalf("formatting failed: %+v", result.Diagnostics)
+			}
+			if result.Formatted != string(golden) {
+				t.Fatalf("formatting a golden file is not idempotent:\ngot:\n%s\nwant:\n%s", result.Formatted, golden)
+			}
+		})
+	}
+}
+
+func TestFormatSourceEmptySource(t *testing.T) {
+	if _, err := FormatSource(""); err == nil {
+		t.Fatal("expected error for empty source")
+	}
+}
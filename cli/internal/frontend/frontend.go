@@ -13,11 +13,31 @@ import (
 	"unsafe"
 )
 
+// Diagnostic severities, shared by every diagnostic-producing API.
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+	SeverityHint    = "hint"
+)
+
 // Diagnostic represents a single parser diagnostic returned by the C frontend.
 type Diagnostic struct {
-	Message string
-	Line    uint64
-	Column  uint64
+	Message  string
+	Line     uint64
+	Column   uint64
+	Severity string
+	Code     string
+}
+
+func severityName(severity C.int) string {
+	switch severity {
+	case C.IMPULSE_SEVERITY_WARNING:
+		return SeverityWarning
+	case C.IMPULSE_SEVERITY_HINT:
+		return SeverityHint
+	default:
+		return SeverityError
+	}
 }
 
 // Result contains the parsing outcome.
@@ -48,9 +68,11 @@ func convertDiagnostics(ptr *C.struct_ImpulseParseDiagnostic, count C.size_t) []
 	for i := 0; i < int(count); i++ {
 		diag := slice[i]
 		result[i] = Diagnostic{
-			Message: C.GoString(diag.message),
-			Line:    uint64(diag.line),
-			Column:  uint64(diag.column),
+			Message:  C.GoString(diag.message),
+			Line:     uint64(diag.line),
+			Column:   uint64(diag.column),
+			Severity: severityName(diag.severity),
+			Code:     C.GoString(diag.code),
 		}
 	}
 	return result
@@ -0,0 +1,126 @@
+package frontend
+
+/*
+#cgo CXXFLAGS: -I${SRCDIR}/../../../frontend/include
+#cgo LDFLAGS: -L${SRCDIR}/../../../build/frontend -L${SRCDIR}/../../../build/ir -limpulse-frontend -limpulse-ir -lstdc++ -lm
+#include <stdlib.h>
+#include "../../../frontend/include/impulse/frontend/frontend.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"unsafe"
+)
+
+// Symbol kinds, as reported in Symbol.Kind.
+const (
+	SymbolKindConstant = "constant"
+	SymbolKindFunction = "function"
+)
+
+// Symbol describes a named binding discovered while parsing a module.
+type Symbol struct {
+	Name   string
+	Kind   string
+	Line   uint64
+	Column uint64
+}
+
+// Module is a persistent handle onto a parsed module. Unlike ParseModule,
+// CheckModule, and EvaluateBindings, it keeps the C++ AST alive so repeated
+// position-based queries (hover, document symbols) don't re-parse the
+// source on every call.
+type Module struct {
+	handle *C.struct_ImpulseModuleHandle
+}
+
+func convertSymbols(ptr *C.struct_ImpulseSymbol, count C.size_t) []Symbol {
+	if ptr == nil || count == 0 {
+		return nil
+	}
+	slice := (*[1 << 30]C.struct_ImpulseSymbol)(unsafe.Pointer(ptr))[:count:count]
+	result := make([]Symbol, count)
+	for i := 0; i < int(count); i++ {
+		sym := slice[i]
+		result[i] = Symbol{
+			Name:   C.GoString(sym.name),
+			Kind:   C.GoString(sym.kind),
+			Line:   uint64(sym.line),
+			Column: uint64(sym.column),
+		}
+	}
+	return result
+}
+
+// OpenModule parses source and keeps the resulting AST alive behind the
+// returned handle. Callers must call Close when done with it.
+func OpenModule(source string) (*Module, Result, error) {
+	if source == "" {
+		return nil, Result{}, errors.New("empty source")
+	}
+
+	cSource := C.CString(source)
+	defer C.free(unsafe.Pointer(cSource))
+
+	options := C.struct_ImpulseParseOptions{
+		path:   nil,
+		source: cSource,
+	}
+
+	var cResult C.struct_ImpulseParseResult
+	handle := C.impulse_module_open(&options, &cResult)
+	defer C.impulse_free_parse_result(&cResult)
+
+	result := Result{
+		Success:     bool(cResult.success),
+		Diagnostics: convertDiagnostics(cResult.diagnostics, cResult.diagnostic_count),
+	}
+
+	if handle == nil {
+		return nil, result, errors.New("module open failed")
+	}
+
+	return &Module{handle: handle}, result, nil
+}
+
+// Close releases the C++ resources backing the module. It is safe to call
+// on a nil *Module.
+func (m *Module) Close() {
+	if m == nil || m.handle == nil {
+		return
+	}
+	C.impulse_module_close(m.handle)
+	m.handle = nil
+}
+
+// Symbols lists every top-level binding in the module, in source order.
+func (m *Module) Symbols() []Symbol {
+	if m == nil || m.handle == nil {
+		return nil
+	}
+	var cSymbols *C.struct_ImpulseSymbol
+	var count C.size_t
+	C.impulse_module_symbols(m.handle, &cSymbols, &count)
+	defer C.impulse_free_symbols(cSymbols, count)
+	return convertSymbols(cSymbols, count)
+}
+
+// SymbolAt returns the binding whose span contains the given byte offset
+// into the original source, or ok=false if no binding covers it.
+func (m *Module) SymbolAt(offset uint64) (symbol Symbol, ok bool) {
+	if m == nil || m.handle == nil {
+		return Symbol{}, false
+	}
+	var cSymbol C.struct_ImpulseSymbol
+	found := C.impulse_module_symbol_at(m.handle, C.size_t(offset), &cSymbol)
+	if !bool(found) {
+		return Symbol{}, false
+	}
+	return Symbol{
+		Name:   C.GoString(cSymbol.name),
+		Kind:   C.GoString(cSymbol.kind),
+		Line:   uint64(cSymbol.line),
+		Column: uint64(cSymbol.column),
+	}, true
+}
@@ -0,0 +1,104 @@
+package frontend
+
+import "testing"
+
+func TestOpenModuleSuccess(t *testing.T) {
+	source := "module test::mod;\nlet answer: int = 42;"
+
+	module, result, err := OpenModule(source)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer module.Close()
+
+	if !result.Success {
+		t.Fatalf("expected success, got diagnostics: %+v", result.Diagnostics)
+	}
+}
+
+func TestOpenModuleEmptySource(t *testing.T) {
+	if _, _, err := OpenModule(""); err == nil {
+		t.Fatal("expected error for empty source")
+	}
+}
+
+func TestModuleSymbols(t *testing.T) {
+	source := "module demo;\nlet a: int = 5;\nlet b: int = a + 3;"
+
+	module, result, err := OpenModule(source)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer module.Close()
+	if !result.Success {
+		t.Fatalf("expected success, got diagnostics: %+v", result.Diagnostics)
+	}
+
+	symbols := module.Symbols()
+	if len(symbols) != 2 {
+		t.Fatalf("expected two symbols, got %d", len(symbols))
+	}
+	if symbols[0].Name != "a" || symbols[1].Name != "b" {
+		t.Fatalf("expected symbols a, b in source order, got %+v", symbols)
+	}
+}
+
+func TestModuleSymbolAt(t *testing.T) {
+	source := "module demo;\nlet a: int = 5;\n"
+
+	module, result, err := OpenModule(source)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer module.Close()
+	if !result.Success {
+		t.Fatalf("expected success, got diagnostics: %+v", result.Diagnostics)
+	}
+
+	offset := uint64(len("module demo;\nlet "))
+	symbol, ok := module.SymbolAt(offset)
+	if !ok {
+		t.Fatal("expected a symbol at the binding's offset")
+	}
+	if symbol.Name != "a" {
+		t.Fatalf("expected symbol a, got %+v", symbol)
+	}
+}
+
+func TestModuleSymbolAtOutOfRange(t *testing.T) {
+	module, result, err := OpenModule("module demo;\nlet a: int = 5;\n")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer module.Close()
+	if !result.Success {
+		t.Fatalf("expected success, got diagnostics: %+v", result.Diagnostics)
+	}
+
+	if _, ok := module.SymbolAt(0); ok {
+		t.Fatal("expected no symbol at the `module demo;` header")
+	}
+}
+
+func TestModuleCloseIsSafeOnNil(t *testing.T) {
+	var module *Module
+	module.Close()
+}
+
+func TestModuleMethodsAfterClose(t *testing.T) {
+	module, _, err := OpenModule("module demo;\nlet a: int = 5;\n")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	module.Close()
+
+	if symbols := module.Symbols(); symbols != nil {
+		t.Fatalf("expected no symbols after Close, got %+v", symbols)
+	}
+	if _, ok := module.SymbolAt(0); ok {
+		t.Fatal("expected SymbolAt to report false after Close")
+	}
+
+	// Close must also be idempotent.
+	module.Close()
+}
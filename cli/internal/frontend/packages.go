@@ -0,0 +1,193 @@
+package frontend
+
+/*
+#cgo CXXFLAGS: -I${SRCDIR}/../../../frontend/include
+#cgo LDFLAGS: -L${SRCDIR}/../../../build/frontend -L${SRCDIR}/../../../build/ir -limpulse-frontend -limpulse-ir -lstdc++ -lm
+#include <stdlib.h>
+#include "../../../frontend/include/impulse/frontend/frontend.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"unsafe"
+)
+
+// Package is one resolved module within a multi-file build: the files that
+// make it up, the diagnostics produced while parsing/checking it, its
+// exported symbols, and the import paths it depends on.
+type Package struct {
+	ImportPath  string
+	Files       []string
+	Diagnostics []Diagnostic
+	Exported    []Symbol
+	Depends     []string
+}
+
+// LoadPackages resolves a mix of import paths, directories, and explicit
+// comma-separated file lists (e.g. "pkg/a.impl,pkg/b.impl") into a graph of
+// parsed and checked packages. Cross-module references such as
+// `module foo::bar` are resolved against the other packages in the graph.
+//
+// Any trailing arguments that aren't recognized as packages, directories,
+// or file lists are returned unchanged so callers can pass them through to
+// a runtime (e.g. `impulsec run ./cmd/foo -- arg1 arg2`).
+func LoadPackages(args []string) ([]*Package, []string, error) {
+	if len(args) == 0 {
+		return nil, nil, errors.New("no packages specified")
+	}
+
+	var inputs []string
+	var passthrough []string
+	for i, arg := range args {
+		if !looksLikePackageArg(arg) {
+			passthrough = append(passthrough, args[i:]...)
+			break
+		}
+		inputs = append(inputs, arg)
+	}
+	if len(inputs) == 0 {
+		return nil, nil, errors.New("no packages specified")
+	}
+
+	files, err := expandPackageArgs(inputs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cOptions := make([]C.struct_ImpulsePackageInput, len(files))
+	cStrings := make([]*C.char, 0, len(files)*2)
+	defer func() {
+		for _, s := range cStrings {
+			C.free(unsafe.Pointer(s))
+		}
+	}()
+	for i, f := range files {
+		importPath := C.CString(f.importPath)
+		path := C.CString(f.path)
+		cStrings = append(cStrings, importPath, path)
+		cOptions[i] = C.struct_ImpulsePackageInput{
+			import_path: importPath,
+			path:        path,
+		}
+	}
+
+	var cResult *C.struct_ImpulsePackageGraph
+	status := C.impulse_load_packages(&cOptions[0], C.size_t(len(cOptions)), &cResult)
+	if !bool(status) || cResult == nil {
+		return nil, nil, errors.New("failed to load packages")
+	}
+	defer C.impulse_free_package_graph(cResult)
+
+	packages := convertPackages(cResult)
+	return packages, passthrough, nil
+}
+
+type packageFile struct {
+	importPath string
+	path       string
+}
+
+// looksLikePackageArg reports whether arg is a Go-style import path
+// ("./cmd/foo", "./..."), a directory, or a comma-separated file list,
+// as opposed to a passthrough runtime argument.
+func looksLikePackageArg(arg string) bool {
+	if strings.HasPrefix(arg, "./") || strings.HasPrefix(arg, "../") {
+		return true
+	}
+	if strings.Contains(arg, ",") {
+		return true
+	}
+	if info, err := os.Stat(arg); err == nil && info.IsDir() {
+		return true
+	}
+	return strings.HasSuffix(arg, ".impl")
+}
+
+// expandPackageArgs turns import paths, directories, "./..." wildcards, and
+// comma-separated file lists into a flat list of (import path, file) pairs.
+func expandPackageArgs(inputs []string) ([]packageFile, error) {
+	var files []packageFile
+	for _, input := range inputs {
+		if strings.Contains(input, ",") {
+			for _, f := range strings.Split(input, ",") {
+				files = append(files, packageFile{importPath: filepath.Dir(f), path: f})
+			}
+			continue
+		}
+
+		if strings.HasSuffix(input, "/...") {
+			root := strings.TrimSuffix(input, "/...")
+			err := filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+				if err != nil {
+					return err
+				}
+				if !d.IsDir() && strings.HasSuffix(path, ".impl") {
+					files = append(files, packageFile{importPath: filepath.Dir(path), path: path})
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		info, err := os.Stat(input)
+		if err != nil {
+			return nil, err
+		}
+		if info.IsDir() {
+			entries, err := os.ReadDir(input)
+			if err != nil {
+				return nil, err
+			}
+			for _, entry := range entries {
+				if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".impl") {
+					files = append(files, packageFile{importPath: input, path: filepath.Join(input, entry.Name())})
+				}
+			}
+			continue
+		}
+		files = append(files, packageFile{importPath: filepath.Dir(input), path: input})
+	}
+	if len(files) == 0 {
+		return nil, errors.New("no source files matched")
+	}
+	return files, nil
+}
+
+func convertPackages(graph *C.struct_ImpulsePackageGraph) []*Package {
+	count := int(graph.package_count)
+	if count == 0 {
+		return nil
+	}
+	slice := (*[1 << 20]C.struct_ImpulsePackage)(unsafe.Pointer(graph.packages))[:count:count]
+	packages := make([]*Package, count)
+	for i, cPkg := range slice {
+		pkg := &Package{
+			ImportPath:  C.GoString(cPkg.import_path),
+			Diagnostics: convertDiagnostics(cPkg.diagnostics, cPkg.diagnostic_count),
+			Exported:    convertSymbols(cPkg.exported, cPkg.exported_count),
+		}
+		pkg.Files = convertStrings(cPkg.files, cPkg.file_count)
+		pkg.Depends = convertStrings(cPkg.depends, cPkg.depend_count)
+		packages[i] = pkg
+	}
+	return packages
+}
+
+func convertStrings(ptr **C.char, count C.size_t) []string {
+	if ptr == nil || count == 0 {
+		return nil
+	}
+	slice := (*[1 << 20]*C.char)(unsafe.Pointer(ptr))[:count:count]
+	result := make([]string, count)
+	for i, s := range slice {
+		result[i] = C.GoString(s)
+	}
+	return result
+}
@@ -0,0 +1,49 @@
+package frontend
+
+import "testing"
+
+func TestLoadPackagesTwoFiles(t *testing.T) {
+	packages, passthrough, err := LoadPackages([]string{"testdata/packages/a/a.impl,testdata/packages/b/b.impl"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(passthrough) != 0 {
+		t.Fatalf("expected no passthrough args, got %v", passthrough)
+	}
+	if len(packages) != 2 {
+		t.Fatalf("expected two packages, got %d", len(packages))
+	}
+}
+
+func TestLoadPackagesCyclicImport(t *testing.T) {
+	packages, _, err := LoadPackages([]string{"testdata/packages/a", "testdata/packages/b"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	found := false
+	for _, pkg := range packages {
+		if len(pkg.Diagnostics) > 0 {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("expected a diagnostic for the cyclic import between pkg::a and pkg::b")
+	}
+}
+
+func TestLoadPackagesNoInput(t *testing.T) {
+	if _, _, err := LoadPackages(nil); err == nil {
+		t.Fatal("expected error for empty args")
+	}
+}
+
+func TestLoadPackagesPassthroughArgs(t *testing.T) {
+	_, passthrough, err := LoadPackages([]string{"testdata/packages/a", "--", "arg1", "arg2"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(passthrough) != 3 {
+		t.Fatalf("expected 3 passthrough args, got %v", passthrough)
+	}
+}
@@ -0,0 +1,60 @@
+package frontend
+
+// Report is the stable JSON document emitted by impulsec in
+// --format=json mode. Every subcommand (emit-ir, check, run, evaluate,
+// and plain parse) fills in whichever fields are relevant to it and
+// leaves the rest at their zero value, which the `omitempty` tags drop
+// from the output.
+type Report struct {
+	Success     bool             `json:"success"`
+	Diagnostics []DiagnosticJSON `json:"diagnostics"`
+	IR          string           `json:"ir,omitempty"`
+	Bindings    []BindingJSON    `json:"bindings,omitempty"`
+	ExitCode    int              `json:"exitCode"`
+}
+
+// DiagnosticJSON is the wire form of a Diagnostic.
+type DiagnosticJSON struct {
+	Line     uint64 `json:"line"`
+	Column   uint64 `json:"column"`
+	Severity string `json:"severity"`
+	Code     string `json:"code"`
+	Message  string `json:"message"`
+}
+
+// BindingJSON is the wire form of a BindingValue.
+type BindingJSON struct {
+	Name      string  `json:"name"`
+	Evaluated bool    `json:"evaluated"`
+	Value     float64 `json:"value"`
+	Message   string  `json:"message,omitempty"`
+}
+
+// DiagnosticsJSON converts parser/checker diagnostics to their wire form.
+func DiagnosticsJSON(diagnostics []Diagnostic) []DiagnosticJSON {
+	result := make([]DiagnosticJSON, len(diagnostics))
+	for i, d := range diagnostics {
+		result[i] = DiagnosticJSON{
+			Line:     d.Line,
+			Column:   d.Column,
+			Severity: d.Severity,
+			Code:     d.Code,
+			Message:  d.Message,
+		}
+	}
+	return result
+}
+
+// BindingsJSON converts evaluated bindings to their wire form.
+func BindingsJSON(bindings []BindingValue) []BindingJSON {
+	result := make([]BindingJSON, len(bindings))
+	for i, b := range bindings {
+		result[i] = BindingJSON{
+			Name:      b.Name,
+			Evaluated: b.Evaluated,
+			Value:     b.Value,
+			Message:   b.Message,
+		}
+	}
+	return result
+}
@@ -0,0 +1,87 @@
+package frontend
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+func TestReportJSONGoldenCheckFailure(t *testing.T) {
+	report := Report{
+		Success: false,
+		Diagnostics: DiagnosticsJSON([]Diagnostic{
+			{Line: 2, Column: 5, Severity: SeverityError, Code: "E0001", Message: `duplicate binding "value"`},
+		}),
+		ExitCode: 2,
+	}
+	assertMatchesGolden(t, "testdata/golden/check_failure.json", report)
+}
+
+func TestReportJSONGoldenEvaluateSuccess(t *testing.T) {
+	report := Report{
+		Success:     true,
+		Diagnostics: []DiagnosticJSON{},
+		Bindings: BindingsJSON([]BindingValue{
+			{Name: "a", Evaluated: true, Value: 5},
+			{Name: "b", Evaluated: true, Value: 8},
+		}),
+		ExitCode: 0,
+	}
+	assertMatchesGolden(t, "testdata/golden/evaluate_success.json", report)
+}
+
+func TestReportJSONGoldenEvaluateZeroValue(t *testing.T) {
+	report := Report{
+		Success:     true,
+		Diagnostics: []DiagnosticJSON{},
+		Bindings: BindingsJSON([]BindingValue{
+			{Name: "z", Evaluated: true, Value: 0},
+		}),
+		ExitCode: 0,
+	}
+	assertMatchesGolden(t, "testdata/golden/evaluate_zero.json", report)
+}
+
+// assertMatchesGolden marshals report and checks it round-trips through
+// encoding/json into the same value as the golden fixture, guaranteeing the
+// schema documented for --format=json stays stable.
+func assertMatchesGolden(t *testing.T, goldenPath string, report Report) {
+	t.Helper()
+
+	goldenBytes, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatalf("failed to read golden file: %v", err)
+	}
+
+	var golden, got map[string]interface{}
+	if err := json.Unmarshal(goldenBytes, &golden); err != nil {
+		t.Fatalf("failed to parse golden file: %v", err)
+	}
+
+	gotBytes, err := json.Marshal(report)
+	if err != nil {
+		t.Fatalf("failed to marshal report: %v", err)
+	}
+	if err := json.Unmarshal(gotBytes, &got); err != nil {
+		t.Fatalf("failed to parse marshaled report: %v", err)
+	}
+
+	if len(golden) != len(got) {
+		t.Fatalf("field count mismatch: golden=%v got=%v", golden, got)
+	}
+	for key, wantValue := range golden {
+		gotValue, ok := got[key]
+		if !ok {
+			t.Fatalf("missing field %q in marshaled report", key)
+		}
+		if !jsonEqual(wantValue, gotValue) {
+			t.Fatalf("field %q mismatch: want %v, got %v", key, wantValue, gotValue)
+		}
+	}
+}
+
+func jsonEqual(a, b interface{}) bool {
+	aBytes, _ := json.Marshal(a)
+	bBytes, _ := json.Marshal(b)
+	return string(aBytes) == string(bBytes)
+}
@@ -0,0 +1,61 @@
+package frontend
+
+/*
+#cgo CXXFLAGS: -I${SRCDIR}/../../../frontend/include
+#cgo LDFLAGS: -L${SRCDIR}/../../../build/frontend -L${SRCDIR}/../../../build/ir -limpulse-frontend -limpulse-ir -lstdc++ -lm
+#include <stdlib.h>
+#include "../../../frontend/include/impulse/frontend/frontend.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"unsafe"
+)
+
+// RunResult is the outcome of running a module by treating one of its
+// constant bindings as the entry point.
+type RunResult struct {
+	Success     bool
+	Diagnostics []Diagnostic
+	HasExitCode bool
+	ExitCode    int
+	Message     string
+}
+
+// RunModule parses, checks, and evaluates source, then invokes the binding
+// named entryBinding as the program's entry point.
+func RunModule(source, entryBinding string) (RunResult, error) {
+	if source == "" {
+		return RunResult{}, errors.New("empty source")
+	}
+	if entryBinding == "" {
+		return RunResult{}, errors.New("empty entry binding")
+	}
+
+	cSource := C.CString(source)
+	defer C.free(unsafe.Pointer(cSource))
+	cEntry := C.CString(entryBinding)
+	defer C.free(unsafe.Pointer(cEntry))
+
+	options := C.struct_ImpulseRunOptions{
+		path:          nil,
+		source:        cSource,
+		entry_binding: cEntry,
+	}
+
+	cResult := C.impulse_run_module(&options)
+	defer C.impulse_free_run_result(&cResult)
+
+	result := RunResult{
+		Success:     bool(cResult.success),
+		Diagnostics: convertDiagnostics(cResult.diagnostics, cResult.diagnostic_count),
+		HasExitCode: bool(cResult.has_exit_code),
+		ExitCode:    int(cResult.exit_code),
+	}
+	if cResult.message != nil {
+		result.Message = C.GoString(cResult.message)
+	}
+
+	return result, nil
+}
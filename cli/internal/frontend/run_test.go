@@ -0,0 +1,28 @@
+package frontend
+
+import "testing"
+
+func TestRunModuleSuccess(t *testing.T) {
+	source := "module demo;\nfunc main() -> int { return 0; }"
+
+	result, err := RunModule(source, "main")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Success {
+		t.Fatalf("expected success, got diagnostics: %+v", result.Diagnostics)
+	}
+}
+
+func TestRunModuleEmptySource(t *testing.T) {
+	if _, err := RunModule("", "main"); err == nil {
+		t.Fatal("expected error for empty source")
+	}
+}
+
+func TestRunModuleEmptyEntryBinding(t *testing.T) {
+	source := "module demo;\nfunc main() -> int { return 0; }"
+	if _, err := RunModule(source, ""); err == nil {
+		t.Fatal("expected error for empty entry binding")
+	}
+}
@@ -0,0 +1,141 @@
+package frontend
+
+/*
+#cgo CXXFLAGS: -I${SRCDIR}/../../../frontend/include
+#cgo LDFLAGS: -L${SRCDIR}/../../../build/frontend -L${SRCDIR}/../../../build/ir -limpulse-frontend -limpulse-ir -lstdc++ -lm
+#include <stdlib.h>
+#include "../../../frontend/include/impulse/frontend/frontend.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"unsafe"
+)
+
+// Session is a long-lived frontend session for the REPL: each call to Feed
+// or Eval is checked and evaluated against the binding environment
+// accumulated from every prior call, rather than re-parsing the whole
+// transcript from scratch.
+type Session struct {
+	handle *C.struct_ImpulseSessionHandle
+}
+
+// NewSession creates an empty session with no bindings.
+func NewSession() (*Session, error) {
+	handle := C.impulse_session_create()
+	if handle == nil {
+		return nil, errors.New("failed to create session")
+	}
+	return &Session{handle: handle}, nil
+}
+
+// Close releases the C++ resources backing the session. Safe to call on a
+// nil *Session.
+func (s *Session) Close() {
+	if s == nil || s.handle == nil {
+		return
+	}
+	C.impulse_session_destroy(s.handle)
+	s.handle = nil
+}
+
+// Feed checks source (one or more bindings, such as a `:load`ed file or a
+// line typed at the prompt) against the accumulated environment and, if it
+// checks out, adds its bindings to the session permanently.
+func (s *Session) Feed(source string) (CheckResult, error) {
+	if s == nil || s.handle == nil {
+		return CheckResult{}, errors.New("session is closed")
+	}
+	if source == "" {
+		return CheckResult{}, errors.New("empty source")
+	}
+
+	cSource := C.CString(source)
+	defer C.free(unsafe.Pointer(cSource))
+
+	var cResult C.struct_ImpulseSemanticResult
+	C.impulse_session_feed(s.handle, cSource, &cResult)
+	defer C.impulse_free_semantic_result(&cResult)
+
+	return CheckResult{
+		Success:     bool(cResult.success),
+		Diagnostics: convertDiagnostics(cResult.diagnostics, cResult.diagnostic_count),
+	}, nil
+}
+
+// Eval evaluates expr against the session's accumulated environment
+// without adding anything to it, as used by the REPL's `:type` command.
+func (s *Session) Eval(expr string) (EvalResult, error) {
+	if s == nil || s.handle == nil {
+		return EvalResult{}, errors.New("session is closed")
+	}
+	if expr == "" {
+		return EvalResult{}, errors.New("empty expression")
+	}
+
+	cExpr := C.CString(expr)
+	defer C.free(unsafe.Pointer(cExpr))
+
+	var cResult C.struct_ImpulseEvalResult
+	C.impulse_session_eval(s.handle, cExpr, &cResult)
+	defer C.impulse_free_eval_result(&cResult)
+
+	return EvalResult{
+		Success:     bool(cResult.success),
+		Diagnostics: convertDiagnostics(cResult.diagnostics, cResult.diagnostic_count),
+		Bindings:    convertBindingValues(cResult.bindings, cResult.binding_count),
+	}, nil
+}
+
+// EmitIR emits textual IR for expr evaluated against the session's
+// accumulated environment, without adding anything to it, as used by the
+// REPL's `:ir` command.
+func (s *Session) EmitIR(expr string) (IRResult, error) {
+	if s == nil || s.handle == nil {
+		return IRResult{}, errors.New("session is closed")
+	}
+	if expr == "" {
+		return IRResult{}, errors.New("empty expression")
+	}
+
+	cExpr := C.CString(expr)
+	defer C.free(unsafe.Pointer(cExpr))
+
+	var cResult C.struct_ImpulseIRResult
+	C.impulse_session_emit_ir(s.handle, cExpr, &cResult)
+	defer C.impulse_free_ir_result(&cResult)
+
+	result := IRResult{
+		Success:     bool(cResult.success),
+		Diagnostics: convertDiagnostics(cResult.diagnostics, cResult.diagnostic_count),
+	}
+	if cResult.ir_text != nil {
+		result.IR = C.GoString(cResult.ir_text)
+	}
+
+	return result, nil
+}
+
+// Symbols lists every binding accumulated in the session so far, for tab
+// completion.
+func (s *Session) Symbols() []Symbol {
+	if s == nil || s.handle == nil {
+		return nil
+	}
+	var cSymbols *C.struct_ImpulseSymbol
+	var count C.size_t
+	C.impulse_session_symbols(s.handle, &cSymbols, &count)
+	defer C.impulse_free_symbols(cSymbols, count)
+	return convertSymbols(cSymbols, count)
+}
+
+// Reset discards every binding accumulated so far, returning the session to
+// a fresh state without destroying it.
+func (s *Session) Reset() error {
+	if s == nil || s.handle == nil {
+		return errors.New("session is closed")
+	}
+	C.impulse_session_reset(s.handle)
+	return nil
+}
@@ -0,0 +1,161 @@
+package frontend
+
+import "testing"
+
+func TestNewSession(t *testing.T) {
+	session, err := NewSession()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer session.Close()
+
+	if symbols := session.Symbols(); len(symbols) != 0 {
+		t.Fatalf("expected a fresh session to have no bindings, got %+v", symbols)
+	}
+}
+
+func TestSessionFeedAccumulatesBindings(t *testing.T) {
+	session, err := NewSession()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer session.Close()
+
+	if _, err := session.Feed("let a: int = 5;"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result, err := session.Feed("let b: int = a + 3;")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Success {
+		t.Fatalf("expected success, got diagnostics: %+v", result.Diagnostics)
+	}
+
+	symbols := session.Symbols()
+	if len(symbols) != 2 {
+		t.Fatalf("expected two accumulated bindings, got %+v", symbols)
+	}
+}
+
+func TestSessionFeedEmptySource(t *testing.T) {
+	session, err := NewSession()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer session.Close()
+
+	if _, err := session.Feed(""); err == nil {
+		t.Fatal("expected error for empty source")
+	}
+}
+
+func TestSessionEvalAgainstAccumulatedEnvironment(t *testing.T) {
+	session, err := NewSession()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer session.Close()
+
+	if _, err := session.Feed("let a: int = 5;"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result, err := session.Eval("a + 1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Success {
+		t.Fatalf("expected success, got diagnostics: %+v", result.Diagnostics)
+	}
+
+	// Eval must not add to the session's accumulated bindings.
+	if symbols := session.Symbols(); len(symbols) != 1 {
+		t.Fatalf("expected Eval to leave bindings unchanged, got %+v", symbols)
+	}
+}
+
+func TestSessionEmitIR(t *testing.T) {
+	session, err := NewSession()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer session.Close()
+
+	if _, err := session.Feed("let a: int = 5;"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result, err := session.EmitIR("a + 1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Success {
+		t.Fatalf("expected success, got diagnostics: %+v", result.Diagnostics)
+	}
+	if result.IR == "" {
+		t.Fatal("expected non-empty IR output")
+	}
+}
+
+func TestSessionEmitIREmptyExpr(t *testing.T) {
+	session, err := NewSession()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer session.Close()
+
+	if _, err := session.EmitIR(""); err == nil {
+		t.Fatal("expected error for empty expression")
+	}
+}
+
+func TestSessionReset(t *testing.T) {
+	session, err := NewSession()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer session.Close()
+
+	if _, err := session.Feed("let a: int = 5;"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := session.Reset(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if symbols := session.Symbols(); len(symbols) != 0 {
+		t.Fatalf("expected Reset to discard accumulated bindings, got %+v", symbols)
+	}
+}
+
+func TestSessionMethodsAfterClose(t *testing.T) {
+	session, err := NewSession()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	session.Close()
+
+	if _, err := session.Feed("let a: int = 5;"); err == nil {
+		t.Fatal("expected Feed to error after Close")
+	}
+	if _, err := session.Eval("1"); err == nil {
+		t.Fatal("expected Eval to error after Close")
+	}
+	if _, err := session.EmitIR("1"); err == nil {
+		t.Fatal("expected EmitIR to error after Close")
+	}
+	if err := session.Reset(); err == nil {
+		t.Fatal("expected Reset to error after Close")
+	}
+	if symbols := session.Symbols(); symbols != nil {
+		t.Fatalf("expected no symbols after Close, got %+v", symbols)
+	}
+
+	// Close must also be idempotent.
+	session.Close()
+}
+
+func TestSessionCloseIsSafeOnNil(t *testing.T) {
+	var session *Session
+	session.Close()
+}
@@ -0,0 +1,86 @@
+package lsp
+
+import "strings"
+
+// document holds the editor's current view of an open file along with its
+// synchronization version.
+type document struct {
+	uri     string
+	version int
+	text    string
+}
+
+// documentCache tracks every open document by URI so handlers can apply
+// incremental edits without asking the editor to resend the whole file.
+type documentCache struct {
+	docs map[string]*document
+}
+
+func newDocumentCache() *documentCache {
+	return &documentCache{docs: make(map[string]*document)}
+}
+
+func (c *documentCache) open(uri string, version int, text string) {
+	c.docs[uri] = &document{uri: uri, version: version, text: text}
+}
+
+func (c *documentCache) close(uri string) {
+	delete(c.docs, uri)
+}
+
+func (c *documentCache) get(uri string) (*document, bool) {
+	doc, ok := c.docs[uri]
+	return doc, ok
+}
+
+// applyChange applies one incremental textDocument/didChange content change
+// to the document. A change with no range replaces the whole text.
+func (c *documentCache) applyChange(uri string, version int, change contentChange) {
+	doc, ok := c.docs[uri]
+	if !ok {
+		return
+	}
+	doc.version = version
+	if change.Range == nil {
+		doc.text = change.Text
+		return
+	}
+	start := positionToOffset(doc.text, change.Range.Start)
+	end := positionToOffset(doc.text, change.Range.End)
+	doc.text = doc.text[:start] + change.Text + doc.text[end:]
+}
+
+// positionToOffset converts a 0-indexed LSP line/character position into a
+// byte offset into text.
+func positionToOffset(text string, pos Position) int {
+	line := 0
+	offset := 0
+	for line < pos.Line {
+		idx := strings.IndexByte(text[offset:], '\n')
+		if idx < 0 {
+			return len(text)
+		}
+		offset += idx + 1
+		line++
+	}
+	lineEnd := strings.IndexByte(text[offset:], '\n')
+	if lineEnd < 0 {
+		lineEnd = len(text) - offset
+	}
+	character := pos.Character
+	if character > lineEnd {
+		character = lineEnd
+	}
+	return offset + character
+}
+
+// offsetToPosition converts a byte offset into text into a 0-indexed LSP
+// line/character position.
+func offsetToPosition(text string, offset int) Position {
+	if offset > len(text) {
+		offset = len(text)
+	}
+	line := strings.Count(text[:offset], "\n")
+	lineStart := strings.LastIndexByte(text[:offset], '\n') + 1
+	return Position{Line: line, Character: offset - lineStart}
+}
@@ -0,0 +1,67 @@
+package lsp
+
+import "testing"
+
+func TestPositionToOffset(t *testing.T) {
+	text := "module demo;\nlet a: int = 1;\n"
+
+	if offset := positionToOffset(text, Position{Line: 0, Character: 0}); offset != 0 {
+		t.Fatalf("expected offset 0, got %d", offset)
+	}
+
+	want := len("module demo;\n")
+	if offset := positionToOffset(text, Position{Line: 1, Character: 0}); offset != want {
+		t.Fatalf("expected offset %d, got %d", want, offset)
+	}
+}
+
+func TestOffsetToPosition(t *testing.T) {
+	text := "module demo;\nlet a: int = 1;\n"
+
+	pos := offsetToPosition(text, len("module demo;\n"))
+	if pos.Line != 1 || pos.Character != 0 {
+		t.Fatalf("expected {1 0}, got %+v", pos)
+	}
+}
+
+func TestDocumentCacheApplyChangeFullReplace(t *testing.T) {
+	cache := newDocumentCache()
+	cache.open("file:///a.impl", 1, "module demo;\n")
+
+	cache.applyChange("file:///a.impl", 2, contentChange{Text: "module demo;\nlet a: int = 1;\n"})
+
+	doc, ok := cache.get("file:///a.impl")
+	if !ok {
+		t.Fatal("expected document to be present")
+	}
+	if doc.version != 2 {
+		t.Fatalf("expected version 2, got %d", doc.version)
+	}
+	if doc.text != "module demo;\nlet a: int = 1;\n" {
+		t.Fatalf("unexpected text: %q", doc.text)
+	}
+}
+
+func TestDocumentCacheApplyChangeIncremental(t *testing.T) {
+	cache := newDocumentCache()
+	cache.open("file:///a.impl", 1, "let a: int = 1;\n")
+
+	// Replace the "1" in "int = 1" with "42".
+	rng := Range{Start: Position{Line: 0, Character: 13}, End: Position{Line: 0, Character: 14}}
+	cache.applyChange("file:///a.impl", 2, contentChange{Range: &rng, Text: "42"})
+
+	doc, _ := cache.get("file:///a.impl")
+	if doc.text != "let a: int = 42;\n" {
+		t.Fatalf("unexpected text after incremental edit: %q", doc.text)
+	}
+}
+
+func TestDocumentCacheClose(t *testing.T) {
+	cache := newDocumentCache()
+	cache.open("file:///a.impl", 1, "module demo;\n")
+	cache.close("file:///a.impl")
+
+	if _, ok := cache.get("file:///a.impl"); ok {
+		t.Fatal("expected document to be removed after close")
+	}
+}
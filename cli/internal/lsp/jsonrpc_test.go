@@ -0,0 +1,39 @@
+package lsp
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestCodecWriteResult(t *testing.T) {
+	var buf bytes.Buffer
+	c := newCodec(nil, &buf)
+
+	if err := c.writeResult([]byte(`1`), map[string]string{"ok": "true"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.HasPrefix(out, "Content-Length: ") {
+		t.Fatalf("expected Content-Length header, got %q", out)
+	}
+	if !strings.Contains(out, `"result":{"ok":"true"}`) {
+		t.Fatalf("expected result payload, got %q", out)
+	}
+}
+
+func TestCodecReadMessage(t *testing.T) {
+	body := `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{}}`
+	raw := "Content-Length: " + strconv.Itoa(len(body)) + "\r\n\r\n" + body
+
+	c := newCodec(strings.NewReader(raw), nil)
+	req, err := c.readMessage()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if req.Method != "initialize" {
+		t.Fatalf("expected method initialize, got %q", req.Method)
+	}
+}
@@ -0,0 +1,261 @@
+package lsp
+
+import (
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/Flickyyy/Impulse-JIT/cli/internal/frontend"
+)
+
+// Server drives the LSP session: it reads framed JSON-RPC messages from its
+// input, dispatches them against the document cache, and writes responses
+// and publishDiagnostics notifications to its output.
+type Server struct {
+	codec     *codec
+	documents *documentCache
+	logger    *log.Logger
+}
+
+// NewServer constructs a Server reading requests from r and writing
+// responses/notifications to w.
+func NewServer(r io.Reader, w io.Writer, logger *log.Logger) *Server {
+	return &Server{
+		codec:     newCodec(r, w),
+		documents: newDocumentCache(),
+		logger:    logger,
+	}
+}
+
+// Run services requests until the input is closed or an "exit" notification
+// is received.
+func (s *Server) Run() error {
+	for {
+		req, err := s.codec.readMessage()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if req.Method == "exit" {
+			return nil
+		}
+		s.dispatch(req)
+	}
+}
+
+func (s *Server) dispatch(req *request) {
+	var err error
+	switch req.Method {
+	case "initialize":
+		err = s.handleInitialize(req)
+	case "initialized", "$/cancelRequest":
+		// No action required.
+	case "textDocument/didOpen":
+		err = s.handleDidOpen(req)
+	case "textDocument/didChange":
+		err = s.handleDidChange(req)
+	case "textDocument/didSave":
+		err = s.handleDidSave(req)
+	case "textDocument/didClose":
+		err = s.handleDidClose(req)
+	case "textDocument/hover":
+		err = s.handleHover(req)
+	case "textDocument/documentSymbol":
+		err = s.handleDocumentSymbol(req)
+	case "shutdown":
+		err = s.codec.writeResult(req.ID, nil)
+	default:
+		if len(req.ID) > 0 {
+			err = s.codec.writeError(req.ID, -32601, fmt.Sprintf("method not found: %s", req.Method))
+		}
+	}
+	if err != nil && s.logger != nil {
+		s.logger.Printf("lsp: error handling %s: %v", req.Method, err)
+	}
+}
+
+func (s *Server) handleInitialize(req *request) error {
+	result := map[string]interface{}{
+		"capabilities": map[string]interface{}{
+			"textDocumentSync": map[string]interface{}{
+				"openClose": true,
+				"change":    2, // incremental
+				"save":      map[string]interface{}{"includeText": false},
+			},
+			"hoverProvider":          true,
+			"documentSymbolProvider": true,
+		},
+	}
+	return s.codec.writeResult(req.ID, result)
+}
+
+func (s *Server) handleDidOpen(req *request) error {
+	var params didOpenParams
+	if err := parseParams(req.Params, &params); err != nil {
+		return err
+	}
+	s.documents.open(params.TextDocument.URI, params.TextDocument.Version, params.TextDocument.Text)
+	return s.publishDiagnostics(params.TextDocument.URI)
+}
+
+func (s *Server) handleDidChange(req *request) error {
+	var params didChangeParams
+	if err := parseParams(req.Params, &params); err != nil {
+		return err
+	}
+	for _, change := range params.ContentChanges {
+		s.documents.applyChange(params.TextDocument.URI, params.TextDocument.Version, change)
+	}
+	return s.publishDiagnostics(params.TextDocument.URI)
+}
+
+func (s *Server) handleDidSave(req *request) error {
+	var params didSaveParams
+	if err := parseParams(req.Params, &params); err != nil {
+		return err
+	}
+	return s.publishDiagnostics(params.TextDocument.URI)
+}
+
+func (s *Server) handleDidClose(req *request) error {
+	var params didCloseParams
+	if err := parseParams(req.Params, &params); err != nil {
+		return err
+	}
+	s.documents.close(params.TextDocument.URI)
+	return s.codec.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+		URI:         params.TextDocument.URI,
+		Diagnostics: nil,
+	})
+}
+
+// publishDiagnostics re-checks the document and sends its current
+// diagnostics, translating the frontend's 1-indexed Line/Column into LSP's
+// 0-indexed Range.
+func (s *Server) publishDiagnostics(uri string) error {
+	doc, ok := s.documents.get(uri)
+	if !ok {
+		return nil
+	}
+	diags := []diagnostic{}
+	if doc.text != "" {
+		checkResult, err := frontend.CheckModule(doc.text)
+		if err == nil {
+			for _, d := range checkResult.Diagnostics {
+				diags = append(diags, toLSPDiagnostic(d))
+			}
+		}
+	}
+	return s.codec.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+		URI:         uri,
+		Diagnostics: diags,
+	})
+}
+
+func toLSPDiagnostic(d frontend.Diagnostic) diagnostic {
+	pos := Position{Line: int(d.Line) - 1, Character: int(d.Column) - 1}
+	return diagnostic{
+		Range:    Range{Start: pos, End: pos},
+		Severity: lspSeverity(d.Severity),
+		Message:  d.Message,
+		Source:   "impulse",
+	}
+}
+
+// lspSeverity maps the frontend's Diagnostic.Severity string onto an LSP
+// DiagnosticSeverity int, defaulting to error for anything unrecognized.
+func lspSeverity(severity string) int {
+	switch severity {
+	case frontend.SeverityWarning:
+		return severityWarning
+	case frontend.SeverityHint:
+		return severityHint
+	default:
+		return severityError
+	}
+}
+
+func (s *Server) handleHover(req *request) error {
+	var params textDocumentPositionParams
+	if err := parseParams(req.Params, &params); err != nil {
+		return err
+	}
+	doc, ok := s.documents.get(params.TextDocument.URI)
+	if !ok {
+		return s.codec.writeResult(req.ID, nil)
+	}
+
+	module, _, err := frontend.OpenModule(doc.text)
+	if err != nil {
+		return s.codec.writeResult(req.ID, nil)
+	}
+	defer module.Close()
+
+	offset := positionToOffset(doc.text, params.Position)
+	sym, ok := module.SymbolAt(uint64(offset))
+	if !ok {
+		return s.codec.writeResult(req.ID, nil)
+	}
+
+	value := fmt.Sprintf("%s: %s", sym.Name, sym.Kind)
+	evalResult, err := frontend.EvaluateBindings(doc.text)
+	if err == nil && evalResult.Success {
+		for _, binding := range evalResult.Bindings {
+			if binding.Name != sym.Name {
+				continue
+			}
+			if binding.Evaluated {
+				value = fmt.Sprintf("%s = %g", sym.Name, binding.Value)
+			}
+			break
+		}
+	}
+
+	return s.codec.writeResult(req.ID, hoverResult{
+		Contents: markupContent{Kind: "plaintext", Value: value},
+	})
+}
+
+func (s *Server) handleDocumentSymbol(req *request) error {
+	var params struct {
+		TextDocument textDocumentIdentifier `json:"textDocument"`
+	}
+	if err := parseParams(req.Params, &params); err != nil {
+		return err
+	}
+	doc, ok := s.documents.get(params.TextDocument.URI)
+	if !ok {
+		return s.codec.writeResult(req.ID, []documentSymbol{})
+	}
+
+	module, _, err := frontend.OpenModule(doc.text)
+	if err != nil {
+		return s.codec.writeResult(req.ID, []documentSymbol{})
+	}
+	defer module.Close()
+
+	symbols := []documentSymbol{}
+	for _, sym := range module.Symbols() {
+		pos := Position{Line: int(sym.Line) - 1, Character: int(sym.Column) - 1}
+		symbols = append(symbols, documentSymbol{
+			Name:           sym.Name,
+			Kind:           lspSymbolKind(sym.Kind),
+			Range:          Range{Start: pos, End: pos},
+			SelectionRange: Range{Start: pos, End: pos},
+		})
+	}
+	return s.codec.writeResult(req.ID, symbols)
+}
+
+// lspSymbolKind maps the frontend's Symbol.Kind string onto an LSP
+// SymbolKind int, defaulting to Constant for anything unrecognized.
+func lspSymbolKind(kind string) int {
+	switch kind {
+	case frontend.SymbolKindFunction:
+		return symbolKindFunction
+	default:
+		return symbolKindConstant
+	}
+}
@@ -0,0 +1,45 @@
+package lsp
+
+import (
+	"testing"
+
+	"github.com/Flickyyy/Impulse-JIT/cli/internal/frontend"
+)
+
+func TestLSPSeverity(t *testing.T) {
+	cases := map[string]int{
+		frontend.SeverityError:   severityError,
+		frontend.SeverityWarning: severityWarning,
+		frontend.SeverityHint:    severityHint,
+		"":                       severityError,
+	}
+	for severity, want := range cases {
+		if got := lspSeverity(severity); got != want {
+			t.Fatalf("lspSeverity(%q) = %d, want %d", severity, got, want)
+		}
+	}
+}
+
+func TestLSPSymbolKind(t *testing.T) {
+	cases := map[string]int{
+		frontend.SymbolKindFunction: symbolKindFunction,
+		frontend.SymbolKindConstant: symbolKindConstant,
+		"":                          symbolKindConstant,
+	}
+	for kind, want := range cases {
+		if got := lspSymbolKind(kind); got != want {
+			t.Fatalf("lspSymbolKind(%q) = %d, want %d", kind, got, want)
+		}
+	}
+}
+
+func TestToLSPDiagnostic(t *testing.T) {
+	d := frontend.Diagnostic{Line: 3, Column: 5, Severity: frontend.SeverityWarning, Message: "unused binding"}
+	got := toLSPDiagnostic(d)
+	if got.Severity != severityWarning {
+		t.Fatalf("expected severityWarning, got %d", got.Severity)
+	}
+	if got.Range.Start.Line != 2 || got.Range.Start.Character != 4 {
+		t.Fatalf("expected 0-indexed position {2 4}, got %+v", got.Range.Start)
+	}
+}
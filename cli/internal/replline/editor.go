@@ -0,0 +1,265 @@
+// Package replline implements a small readline-style line editor: history
+// recall, Ctrl-R reverse search, and tab completion. It talks to the
+// terminal itself (no external dependency) so it only needs a raw-mode
+// implementation per OS; today that's termios_linux.go.
+package replline
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+const (
+	keyCtrlC     = 3
+	keyCtrlD     = 4
+	keyCtrlR     = 18
+	keyTab       = 9
+	keyBackspace = 127
+	keyEnter     = 13
+	keyNewline   = 10
+	keyEscape    = 27
+)
+
+// ErrInterrupted is returned by ReadLine when the user presses Ctrl-C.
+var ErrInterrupted = fmt.Errorf("interrupted")
+
+// Editor reads lines from an interactive terminal with history and
+// completion support.
+type Editor struct {
+	in      *os.File
+	out     io.Writer
+	reader  *bufio.Reader
+	history []string
+
+	// Complete returns completions for the given prefix (e.g. known binding
+	// names). It may be nil, in which case Tab does nothing.
+	Complete func(prefix string) []string
+
+	// NeedsContinuation reports whether the accumulated input is an
+	// unterminated statement that should prompt for another line instead of
+	// being submitted, used for multi-line input.
+	NeedsContinuation func(input string) bool
+}
+
+// New constructs an Editor reading from in and writing prompts/echo to out.
+func New(in *os.File, out io.Writer) *Editor {
+	return &Editor{in: in, out: out, reader: bufio.NewReader(in)}
+}
+
+// History returns every line successfully submitted so far, oldest first.
+func (e *Editor) History() []string {
+	return e.history
+}
+
+// ReadLine prompts for and reads one logical entry, which may span several
+// physical lines when NeedsContinuation says so. Returns io.EOF on Ctrl-D
+// at the start of input, or ErrInterrupted on Ctrl-C. When in is not a
+// terminal, it reads exactly one physical line: NeedsContinuation is not
+// consulted and the line is not added to History.
+func (e *Editor) ReadLine(prompt string) (string, error) {
+	restore, err := rawMode(int(e.in.Fd()))
+	if err != nil {
+		// Not a terminal (e.g. piped input in tests/scripts): fall back to
+		// plain line reading.
+		return e.readLinePlain(prompt)
+	}
+	defer restore()
+
+	var input strings.Builder
+	currentPrompt := prompt
+	for {
+		line, err := e.readOneLine(currentPrompt)
+		if err != nil {
+			return "", err
+		}
+		if input.Len() > 0 {
+			input.WriteByte('\n')
+		}
+		input.WriteString(line)
+
+		if e.NeedsContinuation == nil || !e.NeedsContinuation(input.String()) {
+			break
+		}
+		currentPrompt = strings.Repeat(" ", len(prompt)-3) + "... "
+	}
+
+	result := input.String()
+	if strings.TrimSpace(result) != "" {
+		e.history = append(e.history, result)
+	}
+	return result, nil
+}
+
+// readLinePlain is used when stdin isn't a terminal (scripts, tests): it
+// just reads a line with bufio, skipping history/completion entirely.
+func (e *Editor) readLinePlain(prompt string) (string, error) {
+	fmt.Fprint(e.out, prompt)
+	line, err := e.reader.ReadString('\n')
+	if err != nil && line == "" {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// readOneLine reads a single physical line with full editing support: left
+// /right cursor movement, backspace, history recall, Ctrl-R search, and
+// tab completion.
+func (e *Editor) readOneLine(prompt string) (string, error) {
+	buf := []rune{}
+	cursor := 0
+	historyIdx := len(e.history)
+
+	redraw := func() {
+		fmt.Fprint(e.out, "\r\033[K", prompt, string(buf))
+		if back := len(buf) - cursor; back > 0 {
+			fmt.Fprintf(e.out, "\033[%dD", back)
+		}
+	}
+	redraw()
+
+	for {
+		b, err := e.reader.ReadByte()
+		if err != nil {
+			return "", err
+		}
+
+		switch b {
+		case keyCtrlC:
+			fmt.Fprintln(e.out)
+			return "", ErrInterrupted
+
+		case keyCtrlD:
+			if len(buf) == 0 {
+				fmt.Fprintln(e.out)
+				return "", io.EOF
+			}
+
+		case keyEnter, keyNewline:
+			fmt.Fprintln(e.out)
+			return string(buf), nil
+
+		case keyBackspace:
+			if cursor > 0 {
+				buf = append(buf[:cursor-1], buf[cursor:]...)
+				cursor--
+				redraw()
+			}
+
+		case keyTab:
+			if e.Complete == nil {
+				continue
+			}
+			prefix := string(buf[:cursor])
+			completions := e.Complete(prefix)
+			if len(completions) == 1 {
+				completion := completions[0][len(prefix):]
+				buf = insertRunes(buf, cursor, []rune(completion))
+				cursor += len([]rune(completion))
+				redraw()
+			} else if len(completions) > 1 {
+				fmt.Fprintln(e.out)
+				fmt.Fprintln(e.out, strings.Join(completions, "  "))
+				redraw()
+			}
+
+		case keyCtrlR:
+			line, ok := e.reverseSearch()
+			if ok {
+				buf = []rune(line)
+				cursor = len(buf)
+			}
+			redraw()
+
+		case keyEscape:
+			e.reader.ReadByte() // '['
+			dir, _ := e.reader.ReadByte()
+			switch dir {
+			case 'A': // up
+				if historyIdx > 0 {
+					historyIdx--
+					buf = []rune(e.history[historyIdx])
+					cursor = len(buf)
+					redraw()
+				}
+			case 'B': // down
+				if historyIdx < len(e.history)-1 {
+					historyIdx++
+					buf = []rune(e.history[historyIdx])
+					cursor = len(buf)
+					redraw()
+				} else if historyIdx == len(e.history)-1 {
+					historyIdx++
+					buf = nil
+					cursor = 0
+					redraw()
+				}
+			case 'C': // right
+				if cursor < len(buf) {
+					cursor++
+					redraw()
+				}
+			case 'D': // left
+				if cursor > 0 {
+					cursor--
+					redraw()
+				}
+			}
+
+		default:
+			buf = insertRunes(buf, cursor, []rune{rune(b)})
+			cursor++
+			redraw()
+		}
+	}
+}
+
+// reverseSearch implements a minimal Ctrl-R: it reads characters into a
+// search term and returns the most recent history entry containing it.
+func (e *Editor) reverseSearch() (string, bool) {
+	var term []rune
+	for {
+		fmt.Fprintf(e.out, "\r\033[K(reverse-i-search)`%s': ", string(term))
+		b, err := e.reader.ReadByte()
+		if err != nil {
+			return "", false
+		}
+		switch b {
+		case keyEnter, keyNewline:
+			return searchHistory(e.history, string(term))
+		case keyCtrlC:
+			return "", false
+		case keyBackspace:
+			if len(term) > 0 {
+				term = term[:len(term)-1]
+			}
+		default:
+			term = append(term, rune(b))
+			if match, ok := searchHistory(e.history, string(term)); ok {
+				fmt.Fprintf(e.out, "\r\033[K(reverse-i-search)`%s': %s", string(term), match)
+			}
+		}
+	}
+}
+
+func searchHistory(history []string, term string) (string, bool) {
+	if term == "" {
+		return "", false
+	}
+	for i := len(history) - 1; i >= 0; i-- {
+		if strings.Contains(history[i], term) {
+			return history[i], true
+		}
+	}
+	return "", false
+}
+
+func insertRunes(buf []rune, at int, insert []rune) []rune {
+	result := make([]rune, 0, len(buf)+len(insert))
+	result = append(result, buf[:at]...)
+	result = append(result, insert...)
+	result = append(result, buf[at:]...)
+	return result
+}
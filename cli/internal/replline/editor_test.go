@@ -0,0 +1,34 @@
+package replline
+
+import "testing"
+
+func TestSearchHistoryFindsMostRecentMatch(t *testing.T) {
+	history := []string{"let a: int = 1;", "let b: int = 2;", "let a: int = 3;"}
+
+	match, ok := searchHistory(history, "a:")
+	if !ok {
+		t.Fatal("expected a match")
+	}
+	if match != "let a: int = 3;" {
+		t.Fatalf("expected most recent match, got %q", match)
+	}
+}
+
+func TestSearchHistoryNoMatch(t *testing.T) {
+	if _, ok := searchHistory([]string{"let a: int = 1;"}, "nope"); ok {
+		t.Fatal("expected no match")
+	}
+}
+
+func TestSearchHistoryEmptyTerm(t *testing.T) {
+	if _, ok := searchHistory([]string{"let a: int = 1;"}, ""); ok {
+		t.Fatal("expected empty term to never match")
+	}
+}
+
+func TestInsertRunes(t *testing.T) {
+	got := insertRunes([]rune("let  = 1;"), 4, []rune("a"))
+	if string(got) != "let a = 1;" {
+		t.Fatalf("unexpected result: %q", string(got))
+	}
+}
@@ -0,0 +1,44 @@
+//go:build linux
+
+package replline
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+const (
+	ioctlGetTermios = 0x5401 // TCGETS
+	ioctlSetTermios = 0x5402 // TCSETS
+)
+
+// rawMode puts fd into character-at-a-time, no-echo mode and returns a
+// restore function that puts it back the way it was.
+func rawMode(fd int) (restore func(), err error) {
+	var original syscall.Termios
+	if err := ioctl(fd, ioctlGetTermios, unsafe.Pointer(&original)); err != nil {
+		return nil, err
+	}
+
+	raw := original
+	raw.Lflag &^= syscall.ICANON | syscall.ECHO | syscall.ISIG
+	raw.Iflag &^= syscall.IXON | syscall.ICRNL
+	raw.Cc[syscall.VMIN] = 1
+	raw.Cc[syscall.VTIME] = 0
+
+	if err := ioctl(fd, ioctlSetTermios, unsafe.Pointer(&raw)); err != nil {
+		return nil, err
+	}
+
+	return func() {
+		_ = ioctl(fd, ioctlSetTermios, unsafe.Pointer(&original))
+	}, nil
+}
+
+func ioctl(fd int, request uintptr, arg unsafe.Pointer) error {
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, uintptr(fd), request, uintptr(arg))
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}